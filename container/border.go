@@ -0,0 +1,124 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+// border.go contains options that configure which sides of a container's
+// border get drawn and what they are drawn with.
+
+import (
+	"strings"
+
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/linestyle"
+)
+
+// BorderSide identifies one side of a container's border. Values can be
+// combined with the bitwise-or operator to select more than one side, e.g.
+// BorderTop|BorderBottom draws only a top and a bottom rail and leaves the
+// left and right columns free for the contained widget.
+type BorderSide int
+
+// String implements fmt.Stringer.
+func (bs BorderSide) String() string {
+	if bs == BorderNone {
+		return "BorderNone"
+	}
+
+	var names []string
+	for _, v := range []struct {
+		side BorderSide
+		name string
+	}{
+		{BorderTop, "BorderTop"},
+		{BorderBottom, "BorderBottom"},
+		{BorderLeft, "BorderLeft"},
+		{BorderRight, "BorderRight"},
+	} {
+		if bs&v.side != 0 {
+			names = append(names, v.name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// BorderNone draws no sides at all, identical to not configuring a border
+// on the container.
+const BorderNone BorderSide = 0
+
+const (
+	// BorderTop draws the top side of the border.
+	BorderTop BorderSide = 1 << iota
+
+	// BorderBottom draws the bottom side of the border.
+	BorderBottom
+
+	// BorderLeft draws the left side of the border.
+	BorderLeft
+
+	// BorderRight draws the right side of the border.
+	BorderRight
+)
+
+// BorderAll draws all four sides of the border, this is the default.
+const BorderAll = BorderTop | BorderBottom | BorderLeft | BorderRight
+
+// Borders configures which sides of the border are actually drawn. Defaults
+// to BorderAll, i.e. drawing a full box. Takes effect only when the
+// container has a border configured via Border or BorderChar.
+func Borders(sides BorderSide) Option {
+	return option(func(opts *options) {
+		opts.borderSides = sides
+	})
+}
+
+// BorderChar replaces the line-drawing runes that would normally be used
+// for the requested LineStyle with a single, constant rune on every border
+// cell. Combined with BorderFillStyle and Borders, this lets a "border"
+// degrade to a solid one-cell-thick, colored separator, the way aerc draws
+// its status and tab dividers.
+func BorderChar(r rune) Option {
+	return option(func(opts *options) {
+		opts.borderChar = r
+	})
+}
+
+// BorderFillStyle sets the cell options (e.g. colors) the border is drawn
+// with, overriding the inherited border and focused colors. Typically used
+// together with BorderChar to paint a solid, colored separator instead of
+// line-drawing runes.
+func BorderFillStyle(opts ...cell.Option) Option {
+	return option(func(o *options) {
+		o.borderCellOpts = opts
+	})
+}
+
+// borderRune returns the line-drawing rune used for one side of the border
+// when no BorderChar override was configured.
+func borderRune(ls linestyle.LineStyle, horizontal bool) rune {
+	switch ls {
+	case linestyle.Double:
+		if horizontal {
+			return '═'
+		}
+		return '║'
+	case linestyle.Round, linestyle.Light:
+		fallthrough
+	default:
+		if horizontal {
+			return '─'
+		}
+		return '│'
+	}
+}
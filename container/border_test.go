@@ -0,0 +1,106 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"image"
+	"testing"
+
+	"github.com/mum4k/termdash/linestyle"
+)
+
+func TestUsableWithPerSideBorders(t *testing.T) {
+	tests := []struct {
+		desc  string
+		sides BorderSide
+		want  image.Rectangle
+	}{
+		{
+			desc:  "no border, the whole area is usable",
+			sides: BorderNone,
+			want:  image.Rect(0, 0, 10, 10),
+		},
+		{
+			desc:  "all sides, shrinks by one cell on every edge",
+			sides: BorderAll,
+			want:  image.Rect(1, 1, 9, 9),
+		},
+		{
+			desc:  "top and bottom only, leaves the left and right columns usable",
+			sides: BorderTop | BorderBottom,
+			want:  image.Rect(0, 1, 10, 9),
+		},
+		{
+			desc:  "left and top only",
+			sides: BorderLeft | BorderTop,
+			want:  image.Rect(1, 1, 10, 10),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			c := &Container{
+				area: image.Rect(0, 0, 10, 10),
+				opts: &options{
+					border:      linestyle.Light,
+					borderSides: tc.sides,
+				},
+			}
+
+			got := c.usable()
+			if got != tc.want {
+				t.Errorf("usable => %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasBorder(t *testing.T) {
+	tests := []struct {
+		desc string
+		opts *options
+		want bool
+	}{
+		{
+			desc: "no border configured",
+			opts: &options{border: linestyle.None, borderSides: BorderAll},
+			want: false,
+		},
+		{
+			desc: "border configured with all sides",
+			opts: &options{border: linestyle.Light, borderSides: BorderAll},
+			want: true,
+		},
+		{
+			desc: "border configured but all sides disabled",
+			opts: &options{border: linestyle.Light, borderSides: BorderNone},
+			want: false,
+		},
+		{
+			desc: "no line style but a border char is set",
+			opts: &options{border: linestyle.None, borderSides: BorderTop, borderChar: '-'},
+			want: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			c := &Container{opts: tc.opts}
+			if got := c.hasBorder(); got != tc.want {
+				t.Errorf("hasBorder => %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package container defines a type that groups widgets and draws the
+// borders around them.
+package container
+
+// container.go contains the Container type.
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/mum4k/termdash/linestyle"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+)
+
+// Container groups a widget (or a set of widgets in the future) and is
+// responsible for drawing the border of the area it was given and the widget
+// inside it.
+type Container struct {
+	// parent is the parent container, nil for the root container.
+	parent *Container
+
+	// area is the area in terminal cells this container occupies.
+	area image.Rectangle
+
+	// term is the terminal this container draws onto.
+	term terminalapi.Terminal
+
+	// opts are the options provided to this container.
+	opts *options
+
+	// focusTracker tracks which container in the tree currently has focus.
+	focusTracker *focusTracker
+}
+
+// New returns a new root container that occupies the entire area of the
+// provided terminal.
+func New(t terminalapi.Terminal, opts ...Option) (*Container, error) {
+	size := t.Size()
+	area := image.Rect(0, 0, size.X, size.Y)
+
+	opt := newOptions()
+	for _, o := range opts {
+		o.set(opt)
+	}
+
+	c := &Container{
+		area: area,
+		term: t,
+		opts: opt,
+	}
+	c.focusTracker = newFocusTracker(c)
+	return c, nil
+}
+
+// hasBorder determines if this container has a border configured, i.e.
+// whether a line style or a border character was requested and at least
+// one side of the border is enabled.
+func (c *Container) hasBorder() bool {
+	if c.opts.borderSides == BorderNone {
+		return false
+	}
+	return c.opts.border != linestyle.None || c.opts.borderChar != 0
+}
+
+// hasWidget determines if this container has a widget placed in it.
+func (c *Container) hasWidget() bool {
+	return c.opts.widget != nil
+}
+
+// usable returns the area of the container that is usable by a contained
+// widget, i.e. the area that remains after subtracting the sides of the
+// border that are actually drawn.
+func (c *Container) usable() image.Rectangle {
+	if !c.hasBorder() {
+		return c.area
+	}
+
+	ar := c.area
+	sides := c.opts.borderSides
+	if sides&BorderTop != 0 {
+		ar.Min.Y++
+	}
+	if sides&BorderBottom != 0 {
+		ar.Max.Y--
+	}
+	if sides&BorderLeft != 0 {
+		ar.Min.X++
+	}
+	if sides&BorderRight != 0 {
+		ar.Max.X--
+	}
+	if ar.Min.X > ar.Max.X {
+		ar.Max.X = ar.Min.X
+	}
+	if ar.Min.Y > ar.Max.Y {
+		ar.Max.Y = ar.Min.Y
+	}
+	return ar
+}
+
+// widgetArea returns the area available to the widget placed in this
+// container, after alignment is taken into account.
+func (c *Container) widgetArea() image.Rectangle {
+	us := c.usable()
+	if !c.hasWidget() {
+		return us
+	}
+
+	wOpts := c.opts.widget.Options()
+	wArea := us
+	if wOpts.MinimumSize.X > 0 && wOpts.MinimumSize.X < us.Dx() {
+		wArea.Max.X = wArea.Min.X + wOpts.MinimumSize.X
+	}
+	if wOpts.MinimumSize.Y > 0 && wOpts.MinimumSize.Y < us.Dy() {
+		wArea.Max.Y = wArea.Min.Y + wOpts.MinimumSize.Y
+	}
+
+	wArea = hAlignWidget(c, wArea)
+	wArea = vAlignWidget(c, wArea)
+	return wArea
+}
+
+// Draw draws this container and its widget onto the terminal.
+func (c *Container) Draw() error {
+	if err := drawCont(c); err != nil {
+		return fmt.Errorf("unable to draw container: %v", err)
+	}
+	return nil
+}
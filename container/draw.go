@@ -3,7 +3,6 @@ package container
 // draw.go contains logic to draw containers and the contained widgets.
 
 import (
-	"errors"
 	"fmt"
 	"image"
 
@@ -13,18 +12,6 @@ import (
 	"github.com/mum4k/termdash/draw"
 )
 
-// drawTree draws this container and all of its sub containers.
-func drawTree(c *Container) error {
-	var errStr string
-	preOrder(c, &errStr, visitFunc(func(c *Container) error {
-		return drawCont(c)
-	}))
-	if errStr != "" {
-		return errors.New(errStr)
-	}
-	return nil
-}
-
 // drawBorder draws the border around the container if requested.
 func drawBorder(c *Container) error {
 	if !c.hasBorder() {
@@ -41,26 +28,74 @@ func drawBorder(c *Container) error {
 		return err
 	}
 
-	var opts []cell.Option
-	if c.focusTracker.isActive(c) {
-		opts = append(opts, cell.FgColor(c.opts.inherited.focusedColor))
-	} else {
-		opts = append(opts, cell.FgColor(c.opts.inherited.borderColor))
+	opts := c.opts.borderCellOpts
+	if opts == nil {
+		if c.focusTracker.isActive(c) {
+			opts = []cell.Option{cell.FgColor(c.opts.inherited.focusedColor)}
+		} else {
+			opts = []cell.Option{cell.FgColor(c.opts.inherited.borderColor)}
+		}
 	}
-	if err := draw.Box(cvs, ar, c.opts.border, opts...); err != nil {
+
+	if c.opts.borderSides == BorderAll && c.opts.borderChar == 0 {
+		if err := draw.Box(cvs, ar, c.opts.border, opts...); err != nil {
+			return err
+		}
+		return cvs.Apply(c.term)
+	}
+
+	if err := drawBorderSides(cvs, ar, c.opts, opts); err != nil {
 		return err
 	}
 	return cvs.Apply(c.term)
 }
 
+// drawBorderSides draws only the sides of the border requested via Borders,
+// either using the line-drawing runes of the configured LineStyle, or, when
+// BorderChar was set, a solid, single-rune fill — the pattern aerc uses
+// for its status and tab dividers.
+func drawBorderSides(cvs *canvas.Canvas, ar image.Rectangle, opts *options, cellOpts []cell.Option) error {
+	sides := opts.borderSides
+	hChar, vChar := borderRune(opts.border, true), borderRune(opts.border, false)
+	if opts.borderChar != 0 {
+		hChar, vChar = opts.borderChar, opts.borderChar
+	}
+
+	if sides&BorderTop != 0 {
+		top := image.Rect(ar.Min.X, ar.Min.Y, ar.Max.X, ar.Min.Y+1)
+		if err := draw.Fill(cvs, top, hChar, cellOpts...); err != nil {
+			return err
+		}
+	}
+	if sides&BorderBottom != 0 {
+		bottom := image.Rect(ar.Min.X, ar.Max.Y-1, ar.Max.X, ar.Max.Y)
+		if err := draw.Fill(cvs, bottom, hChar, cellOpts...); err != nil {
+			return err
+		}
+	}
+	if sides&BorderLeft != 0 {
+		left := image.Rect(ar.Min.X, ar.Min.Y, ar.Min.X+1, ar.Max.Y)
+		if err := draw.Fill(cvs, left, vChar, cellOpts...); err != nil {
+			return err
+		}
+	}
+	if sides&BorderRight != 0 {
+		right := image.Rect(ar.Max.X-1, ar.Min.Y, ar.Max.X, ar.Max.Y)
+		if err := draw.Fill(cvs, right, vChar, cellOpts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // hAlignWidget adjusts the given widget area within the containers area
 // based on the requested horizontal alignment.
 func hAlignWidget(c *Container, wArea image.Rectangle) image.Rectangle {
 	gap := c.usable().Dx() - wArea.Dx()
 	switch c.opts.hAlign {
-	case hAlignTypeRight:
+	case AlignRight:
 		// Use gap from above.
-	case hAlignTypeCenter:
+	case AlignCenter:
 		gap /= 2
 	default:
 		// Left or unknown.
@@ -80,9 +115,9 @@ func hAlignWidget(c *Container, wArea image.Rectangle) image.Rectangle {
 func vAlignWidget(c *Container, wArea image.Rectangle) image.Rectangle {
 	gap := c.usable().Dy() - wArea.Dy()
 	switch c.opts.vAlign {
-	case vAlignTypeBottom:
+	case AlignBottom:
 		// Use gap from above.
-	case vAlignTypeMiddle:
+	case AlignMiddle:
 		gap /= 2
 	default:
 		// Top or unknown.
@@ -141,7 +176,11 @@ func drawResize(c *Container, area image.Rectangle) error {
 		return err
 	}
 
-	if err := draw.Text(cvs, "⇄", draw.TextBounds{}); err != nil {
+	size := cvs.Size()
+	if err := draw.Fill(cvs, image.Rect(0, 0, size.X, size.Y), ' '); err != nil {
+		return err
+	}
+	if err := draw.Text(cvs, "⇄", image.Point{0, 0}); err != nil {
 		return err
 	}
 	return cvs.Apply(c.term)
@@ -161,4 +200,4 @@ func drawCont(c *Container) error {
 		return fmt.Errorf("unable to draw widget %T: %v", c.opts.widget, err)
 	}
 	return nil
-}
\ No newline at end of file
+}
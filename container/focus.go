@@ -0,0 +1,40 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+// focus.go tracks which container in the tree currently has input focus.
+
+// focusTracker tracks which container currently has the keyboard and mouse
+// focus.
+type focusTracker struct {
+	// root is the root of the container tree.
+	root *Container
+	// active is the container that currently has focus.
+	active *Container
+}
+
+// newFocusTracker creates a new focusTracker rooted at root. The root
+// container starts out focused.
+func newFocusTracker(root *Container) *focusTracker {
+	return &focusTracker{
+		root:   root,
+		active: root,
+	}
+}
+
+// isActive determines if the provided container currently has focus.
+func (ft *focusTracker) isActive(c *Container) bool {
+	return ft.active == c
+}
@@ -0,0 +1,151 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+// options.go contains options used to configure the behavior of a container.
+
+import (
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/linestyle"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+// Option is used to provide options to a container.
+type Option interface {
+	// set sets the provided option.
+	set(*options)
+}
+
+// option implements Option.
+type option func(*options)
+
+// set implements Option.set.
+func (o option) set(opts *options) {
+	o(opts)
+}
+
+// HAlign identifies the horizontal alignment of the widget placed inside
+// the container.
+type HAlign int
+
+const (
+	// AlignLeft aligns the widget against the left edge of the container.
+	// This is the default.
+	AlignLeft HAlign = iota
+	// AlignCenter centers the widget horizontally within the container.
+	AlignCenter
+	// AlignRight aligns the widget against the right edge of the container.
+	AlignRight
+)
+
+// VAlign identifies the vertical alignment of the widget placed inside the
+// container.
+type VAlign int
+
+const (
+	// AlignTop aligns the widget against the top edge of the container.
+	// This is the default.
+	AlignTop VAlign = iota
+	// AlignMiddle centers the widget vertically within the container.
+	AlignMiddle
+	// AlignBottom aligns the widget against the bottom edge of the
+	// container.
+	AlignBottom
+)
+
+// inherited are options that children containers inherit from their parent
+// unless they override them themselves.
+type inherited struct {
+	// borderColor is the color used to draw an unfocused border.
+	borderColor cell.Color
+	// focusedColor is the color used to draw the border of a focused container.
+	focusedColor cell.Color
+}
+
+// options stores the options provided to a container.
+type options struct {
+	inherited inherited
+
+	// border is the line style used to draw the border, None if the
+	// container has no border.
+	border linestyle.LineStyle
+	// borderSides identifies which sides of the border get drawn.
+	borderSides BorderSide
+	// borderChar, if non-zero, replaces the line-drawing rune with a
+	// single, constant rune on every cell of the border.
+	borderChar rune
+	// borderCellOpts are the cell options the border (or its solid fill
+	// replacement) is drawn with. Nil means the inherited border/focused
+	// color is used instead.
+	borderCellOpts []cell.Option
+
+	hAlign HAlign
+	vAlign VAlign
+
+	widget widgetapi.Widget
+}
+
+// newOptions returns a new options instance with the default values.
+func newOptions() *options {
+	return &options{
+		border:      linestyle.None,
+		borderSides: BorderAll,
+	}
+}
+
+// Border configures the container to draw a border around itself using the
+// provided line style.
+func Border(ls linestyle.LineStyle) Option {
+	return option(func(opts *options) {
+		opts.border = ls
+	})
+}
+
+// BorderColor sets the color of the border when the container isn't focused.
+func BorderColor(color cell.Color) Option {
+	return option(func(opts *options) {
+		opts.inherited.borderColor = color
+	})
+}
+
+// FocusedColor sets the color of the border when the container is focused.
+func FocusedColor(color cell.Color) Option {
+	return option(func(opts *options) {
+		opts.inherited.focusedColor = color
+	})
+}
+
+// AlignHorizontal sets the horizontal alignment of the widget placed inside
+// the container.
+func AlignHorizontal(h HAlign) Option {
+	return option(func(opts *options) {
+		opts.hAlign = h
+	})
+}
+
+// AlignVertical sets the vertical alignment of the widget placed inside the
+// container.
+func AlignVertical(v VAlign) Option {
+	return option(func(opts *options) {
+		opts.vAlign = v
+	})
+}
+
+// PlaceWidget places the provided widget into the container.
+func PlaceWidget(w widgetapi.Widget) Option {
+	return option(func(opts *options) {
+		opts.widget = w
+	})
+}
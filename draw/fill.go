@@ -0,0 +1,53 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package draw
+
+// fill.go contains code that paints a solid rectangular area of a canvas.
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/mum4k/termdash/canvas"
+	"github.com/mum4k/termdash/cell"
+)
+
+// Fill paints every cell in area with the rune r using the provided cell
+// options. This is the primitive widgets and the container package use to
+// paint background swaths, e.g. to clear an area before drawing something
+// else on top of it, or to paint a solid, single-rune separator.
+//
+// Returns an error if area doesn't fit inside the canvas. If r is a
+// full-width rune and area has an odd width, the rightmost column of area
+// is left untouched, since a full-width rune can never be split across two
+// cells; callers that need exact coverage should either pick an even-width
+// area or a half-width rune.
+func Fill(cvs *canvas.Canvas, area image.Rectangle, r rune, opts ...cell.Option) error {
+	size := cvs.Size()
+	full := image.Rect(0, 0, size.X, size.Y)
+	if !area.In(full) {
+		return fmt.Errorf("the area %v doesn't fit inside the canvas area %v", area, full)
+	}
+
+	step := runeWidth(r)
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x+step <= area.Max.X; x += step {
+			if err := cvs.SetCell(image.Point{X: x, Y: y}, r, opts...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package draw
+
+import (
+	"image"
+	"testing"
+
+	"github.com/mum4k/termdash/canvas"
+	"github.com/mum4k/termdash/canvas/testcanvas"
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/terminal/faketerm"
+)
+
+func TestFill(t *testing.T) {
+	tests := []struct {
+		desc    string
+		canvas  image.Rectangle
+		area    image.Rectangle
+		r       rune
+		opts    []cell.Option
+		want    func(size image.Point) *faketerm.Terminal
+		wantErr bool
+	}{
+		{
+			desc:   "area falls outside of the canvas",
+			canvas: image.Rect(0, 0, 2, 2),
+			area:   image.Rect(0, 0, 3, 2),
+			r:      ' ',
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "fills the entire canvas",
+			canvas: image.Rect(0, 0, 2, 2),
+			area:   image.Rect(0, 0, 2, 2),
+			r:      'x',
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'x')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'x')
+				testcanvas.MustSetCell(c, image.Point{0, 1}, 'x')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, 'x')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "fills a sub-rectangle, clips to it",
+			canvas: image.Rect(0, 0, 3, 3),
+			area:   image.Rect(1, 1, 3, 3),
+			r:      'x',
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{1, 1}, 'x')
+				testcanvas.MustSetCell(c, image.Point{2, 1}, 'x')
+				testcanvas.MustSetCell(c, image.Point{1, 2}, 'x')
+				testcanvas.MustSetCell(c, image.Point{2, 2}, 'x')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "fills with cell options",
+			canvas: image.Rect(0, 0, 1, 1),
+			area:   image.Rect(0, 0, 1, 1),
+			r:      ' ',
+			opts: []cell.Option{
+				cell.BgColor(cell.ColorRed),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, ' ', cell.BgColor(cell.ColorRed))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "full-width rune, even width area, fills every other column",
+			canvas: image.Rect(0, 0, 4, 1),
+			area:   image.Rect(0, 0, 4, 1),
+			r:      '界',
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '界')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, '界')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "full-width rune, odd width area, leaves the last column untouched",
+			canvas: image.Rect(0, 0, 3, 1),
+			area:   image.Rect(0, 0, 3, 1),
+			r:      '界',
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '界')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, err := canvas.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+
+			err = Fill(c, tc.area, tc.r, tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Fill => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := faketerm.New(c.Size())
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+
+			if err := c.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+
+			if diff := faketerm.Diff(tc.want(c.Size()), got); diff != "" {
+				t.Errorf("Fill => %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,619 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package draw provides functions that draw shapes and text on a canvas.
+package draw
+
+// text.go contains code that draws text onto a 2-D canvas.
+
+import (
+	"fmt"
+	"image"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mum4k/termdash/canvas"
+	"github.com/mum4k/termdash/cell"
+)
+
+// OverrunMode indicates the behavior when the text line is too long to fit
+// into the canvas (or the area delimited by MaxX and MaxY).
+type OverrunMode int
+
+// String implements fmt.Stringer.
+func (om OverrunMode) String() string {
+	if n, ok := overrunModeNames[om]; ok {
+		return n
+	}
+	return "OverrunModeUnknown"
+}
+
+// overrunModeNames maps OverrunMode values to human readable names.
+var overrunModeNames = map[OverrunMode]string{
+	OverrunModeStrict:   "OverrunModeStrict",
+	OverrunModeTrim:     "OverrunModeTrim",
+	OverrunModeThreeDot: "OverrunModeThreeDot",
+	OverrunModeMiddle:   "OverrunModeMiddle",
+	OverrunModeWord:     "OverrunModeWord",
+}
+
+const (
+	// OverrunModeStrict results in an error if the text overruns the
+	// provided area. This is the default overrun mode.
+	OverrunModeStrict OverrunMode = iota
+
+	// OverrunModeTrim trims the overrunning part of the text.
+	OverrunModeTrim
+
+	// OverrunModeThreeDot substitutes the last visible character with an
+	// ellipsis (…) when the text overruns the provided area.
+	OverrunModeThreeDot
+
+	// OverrunModeMiddle keeps a prefix and a suffix of the text joined by an
+	// ellipsis (…), e.g. "/very/long/…/path". The available cells are split
+	// roughly in half between the prefix and the suffix, biasing the extra
+	// cell to the prefix when the budget is odd. Useful for paths.
+	OverrunModeMiddle
+
+	// OverrunModeWord behaves like OverrunModeThreeDot, but backs up from
+	// the trim point to the previous whitespace boundary before placing the
+	// ellipsis, so a trimmed word isn't left dangling. Falls back to a hard
+	// character trim if the visible window contains no whitespace.
+	OverrunModeWord
+
+	// overrunModeMax is a marker for the last known value, used to validate
+	// that a given mode is supported. Keep it last in this block.
+	overrunModeMax
+)
+
+// WrapMode indicates how Text folds text that doesn't fit on one row into
+// multiple rows.
+type WrapMode int
+
+// String implements fmt.Stringer.
+func (wm WrapMode) String() string {
+	if n, ok := wrapModeNames[wm]; ok {
+		return n
+	}
+	return "WrapModeUnknown"
+}
+
+// wrapModeNames maps WrapMode values to human readable names.
+var wrapModeNames = map[WrapMode]string{
+	WrapModeNone:      "WrapModeNone",
+	WrapModeCharBreak: "WrapModeCharBreak",
+	WrapModeWordBreak: "WrapModeWordBreak",
+}
+
+const (
+	// WrapModeNone is the default, the text is never wrapped onto
+	// additional rows. The OverrunMode determines what happens to the part
+	// of the text that doesn't fit on the row.
+	WrapModeNone WrapMode = iota
+
+	// WrapModeCharBreak wraps the text onto additional rows when it
+	// doesn't fit, breaking at cell boundaries. A full-width rune is never
+	// split between two rows.
+	WrapModeCharBreak
+
+	// WrapModeWordBreak wraps the text onto additional rows similarly to
+	// WrapModeCharBreak, but prefers to break on runs of whitespace. A
+	// single word that is too long to fit on an empty row falls back to
+	// breaking at cell boundaries.
+	WrapModeWordBreak
+
+	// wrapModeMax is a marker for the last known value.
+	wrapModeMax
+)
+
+// HAlign indicates the horizontal alignment of text within the cells it is
+// drawn into, see TextHAlign and TextBounds.
+type HAlign int
+
+// String implements fmt.Stringer.
+func (h HAlign) String() string {
+	if n, ok := hAlignNames[h]; ok {
+		return n
+	}
+	return "HAlignUnknown"
+}
+
+// hAlignNames maps HAlign values to human readable names.
+var hAlignNames = map[HAlign]string{
+	AlignLeft:   "AlignLeft",
+	AlignCenter: "AlignCenter",
+	AlignRight:  "AlignRight",
+}
+
+const (
+	// AlignLeft is the default, text starts at the left edge of the
+	// available cells.
+	AlignLeft HAlign = iota
+
+	// AlignCenter centers the text within the available cells. When the gap
+	// is odd, the text sits one cell closer to the left edge.
+	AlignCenter
+
+	// AlignRight hugs the text against the right edge of the available
+	// cells.
+	AlignRight
+
+	// hAlignMax is a marker for the last known value, used to validate that
+	// a given alignment is supported. Keep it last in this block.
+	hAlignMax
+)
+
+// TextOption is used to provide options to Text().
+type TextOption interface {
+	// set sets the provided option.
+	set(*textOptions)
+}
+
+// textOptions stores the options provided to Text().
+type textOptions struct {
+	cellOpts    []cell.Option
+	overrunMode OverrunMode
+	wrapMode    WrapMode
+	hAlign      HAlign
+
+	maxX    int
+	maxXSet bool
+	maxY    int
+	maxYSet bool
+
+	bounds    image.Rectangle
+	boundsSet bool
+}
+
+// newTextOptions returns a new textOptions instance with the default values.
+func newTextOptions() *textOptions {
+	return &textOptions{}
+}
+
+// textOption implements TextOption.
+type textOption func(*textOptions)
+
+// set implements TextOption.set.
+func (to textOption) set(opts *textOptions) {
+	to(opts)
+}
+
+// TextCellOpts sets options on the cells that contain the text.
+func TextCellOpts(opts ...cell.Option) TextOption {
+	return textOption(func(o *textOptions) {
+		o.cellOpts = opts
+	})
+}
+
+// TextOverrunMode indicates how to handle the situation when the text
+// doesn't fit on the row. Defaults to OverrunModeStrict.
+func TextOverrunMode(om OverrunMode) TextOption {
+	return textOption(func(o *textOptions) {
+		o.overrunMode = om
+	})
+}
+
+// TextWrapMode configures whether and how the text gets wrapped onto
+// multiple rows when it doesn't fit. Defaults to WrapModeNone.
+func TextWrapMode(wm WrapMode) TextOption {
+	return textOption(func(o *textOptions) {
+		o.wrapMode = wm
+	})
+}
+
+// TextMaxX sets the maximum permitted value of the X coordinate, text
+// cannot be drawn on or after this coordinate. Defaults to the width of the
+// canvas.
+func TextMaxX(maxX int) TextOption {
+	return textOption(func(o *textOptions) {
+		o.maxX = maxX
+		o.maxXSet = true
+	})
+}
+
+// TextMaxY sets the maximum permitted value of the Y coordinate, rows of
+// text cannot be drawn on or after this coordinate. Only relevant when the
+// text is wrapped onto multiple rows, see TextWrapMode. Defaults to the
+// height of the canvas.
+func TextMaxY(maxY int) TextOption {
+	return textOption(func(o *textOptions) {
+		o.maxY = maxY
+		o.maxYSet = true
+	})
+}
+
+// TextHAlign sets the horizontal alignment of the text within the available
+// cells, i.e. those between start.X and MaxX, or, if TextBounds is also
+// provided, those between its Min.X and Max.X. Alignment is applied to the
+// visible string, after any trimming the OverrunMode performed, so e.g. a
+// right-aligned string that got trimmed still hugs the right edge. Defaults
+// to AlignLeft.
+func TextHAlign(h HAlign) TextOption {
+	return textOption(func(o *textOptions) {
+		o.hAlign = h
+	})
+}
+
+// TextBounds restricts the horizontal cells the text may be aligned and
+// drawn within to those between bounds.Min.X and bounds.Max.X of the row(s)
+// starting at start.Y, letting a caller ask to "render this string aligned
+// right within cells x1..x2 of row y" without pre-measuring rune widths.
+// Only the X range of bounds is used. Defaults to the cells between start.X
+// and MaxX.
+func TextBounds(bounds image.Rectangle) TextOption {
+	return textOption(func(o *textOptions) {
+		o.bounds = bounds
+		o.boundsSet = true
+	})
+}
+
+// Text draws the provided text onto the canvas starting at the point start.
+// Returns an error if the start point falls outside of the canvas or if the
+// text doesn't fit and the configured OverrunMode or WrapMode doesn't allow
+// for that.
+func Text(cvs *canvas.Canvas, text string, start image.Point, opts ...TextOption) error {
+	opt := newTextOptions()
+	for _, o := range opts {
+		o.set(opt)
+	}
+
+	size := cvs.Size()
+	area := image.Rect(0, 0, size.X, size.Y)
+	if !start.In(area) {
+		return fmt.Errorf("the requested start point %v falls outside of the canvas area %v", start, area)
+	}
+	if opt.overrunMode < 0 || opt.overrunMode >= overrunModeMax {
+		return fmt.Errorf("unsupported overrun mode %v(%d)", opt.overrunMode, opt.overrunMode)
+	}
+	if opt.wrapMode < 0 || opt.wrapMode >= wrapModeMax {
+		return fmt.Errorf("unsupported wrap mode %v(%d)", opt.wrapMode, opt.wrapMode)
+	}
+	if opt.hAlign < 0 || opt.hAlign >= hAlignMax {
+		return fmt.Errorf("unsupported horizontal alignment %v(%d)", opt.hAlign, opt.hAlign)
+	}
+
+	maxX := size.X
+	if opt.maxXSet {
+		if opt.maxX < 0 || opt.maxX > size.X {
+			return fmt.Errorf("invalid MaxX(%d), must be in range 0 <= MaxX <= canvas width(%d)", opt.maxX, size.X)
+		}
+		maxX = opt.maxX
+	}
+	maxY := size.Y
+	if opt.maxYSet {
+		if opt.maxY < 0 || opt.maxY > size.Y {
+			return fmt.Errorf("invalid MaxY(%d), must be in range 0 <= MaxY <= canvas height(%d)", opt.maxY, size.Y)
+		}
+		maxY = opt.maxY
+	}
+
+	minX := start.X
+	if opt.boundsSet {
+		minX, maxX = opt.bounds.Min.X, opt.bounds.Max.X
+		if minX < 0 || maxX > size.X || minX > maxX {
+			return fmt.Errorf("invalid TextBounds(%v), the X range must fall within the canvas area %v", opt.bounds, area)
+		}
+	}
+
+	rowWidth := maxX - minX
+	if text != "" && rowWidth <= 0 {
+		return fmt.Errorf("text %q doesn't fit, MaxX(%d) leaves no usable cells starting at X:%d", text, maxX, minX)
+	}
+
+	switch opt.wrapMode {
+	case WrapModeNone:
+		return renderRow(cvs, text, start.Y, minX, maxX, opt.overrunMode, opt.hAlign, opt.cellOpts)
+
+	case WrapModeCharBreak, WrapModeWordBreak:
+		return drawWrapped(cvs, text, start.Y, minX, maxX, maxY, opt)
+
+	default:
+		return fmt.Errorf("unsupported wrap mode %v(%d)", opt.wrapMode, opt.wrapMode)
+	}
+}
+
+// drawWrapped splits text onto multiple rows according to opt.wrapMode and
+// draws as many of them as fit between startY and maxY, within the cells
+// between minX and maxX. The last available row receives all the text that
+// remains and has the configured OverrunMode applied to it.
+func drawWrapped(cvs *canvas.Canvas, text string, startY, minX, maxX, maxY int, opt *textOptions) error {
+	maxRows := maxY - startY
+	if text != "" && maxRows <= 0 {
+		return fmt.Errorf("text %q doesn't fit, MaxY(%d) leaves no usable rows starting at Y:%d", text, maxY, startY)
+	}
+	rowWidth := maxX - minX
+
+	nextRow := nextCharBreakRow
+	if opt.wrapMode == WrapModeWordBreak {
+		nextRow = nextWordBreakRow
+	}
+
+	remaining := text
+	for row := 0; row < maxRows; row++ {
+		if remaining == "" {
+			return nil
+		}
+		y := startY + row
+		if row == maxRows-1 {
+			// The last available row gets whatever text remains, letting
+			// the configured OverrunMode decide how to handle any overrun.
+			return renderRow(cvs, remaining, y, minX, maxX, opt.overrunMode, opt.hAlign, opt.cellOpts)
+		}
+
+		chunk, rest := nextRow(remaining, rowWidth)
+		if err := renderRow(cvs, chunk, y, minX, maxX, OverrunModeTrim, opt.hAlign, opt.cellOpts); err != nil {
+			return err
+		}
+		remaining = rest
+	}
+	return nil
+}
+
+// alignStart returns the X coordinate a string of the given width should
+// start at in order to achieve the requested horizontal alignment within
+// the cells between minX and maxX.
+func alignStart(minX, maxX, width int, h HAlign) int {
+	switch h {
+	case AlignRight:
+		return maxX - width
+	case AlignCenter:
+		return minX + (maxX-minX-width)/2
+	default: // AlignLeft.
+		return minX
+	}
+}
+
+// renderRow draws a single row of text on row y, applying the overrun mode
+// if content is wider than the cells between minX and maxX, then aligning
+// the resulting visible string (i.e. after any trim the OverrunMode
+// performed) within those cells per hAlign.
+func renderRow(cvs *canvas.Canvas, content string, y, minX, maxX int, om OverrunMode, hAlign HAlign, cellOpts []cell.Option) error {
+	if content == "" {
+		return nil
+	}
+	rowWidth := maxX - minX
+
+	if cellWidth(content) <= rowWidth {
+		start := image.Point{X: alignStart(minX, maxX, cellWidth(content), hAlign), Y: y}
+		return writeCells(cvs, content, start, cellOpts)
+	}
+
+	switch om {
+	case OverrunModeStrict:
+		return fmt.Errorf("the text %q takes %d cells and doesn't fit into the available %d cells, set a different OverrunMode to allow drawing a trimmed version of it", content, cellWidth(content), rowWidth)
+
+	case OverrunModeTrim:
+		fit, _ := splitAtWidth(content, rowWidth)
+		start := image.Point{X: alignStart(minX, maxX, cellWidth(fit), hAlign), Y: y}
+		return writeCells(cvs, fit, start, cellOpts)
+
+	case OverrunModeThreeDot:
+		budget := rowWidth - 1 // Reserve one cell for the ellipsis.
+		var fit string
+		if budget > 0 {
+			fit, _ = splitAtWidth(content, budget)
+		}
+		start := image.Point{X: alignStart(minX, maxX, cellWidth(fit)+1, hAlign), Y: y}
+		if err := writeCells(cvs, fit, start, cellOpts); err != nil {
+			return err
+		}
+		ellipsis := image.Point{X: start.X + cellWidth(fit), Y: y}
+		return cvs.SetCell(ellipsis, '…', cellOpts...)
+
+	case OverrunModeWord:
+		budget := rowWidth - 1 // Reserve one cell for the ellipsis.
+		var fit string
+		if budget > 0 {
+			fit, _ = splitAtWidth(content, budget)
+			if trimmed, ok := trimToWordBoundary(fit); ok {
+				fit = trimmed
+			}
+		}
+		start := image.Point{X: alignStart(minX, maxX, cellWidth(fit)+1, hAlign), Y: y}
+		if err := writeCells(cvs, fit, start, cellOpts); err != nil {
+			return err
+		}
+		ellipsis := image.Point{X: start.X + cellWidth(fit), Y: y}
+		return cvs.SetCell(ellipsis, '…', cellOpts...)
+
+	case OverrunModeMiddle:
+		budget := rowWidth - 1 // Reserve one cell for the ellipsis.
+		if budget <= 0 {
+			if rowWidth < 1 {
+				return nil
+			}
+			start := image.Point{X: alignStart(minX, maxX, 1, hAlign), Y: y}
+			return cvs.SetCell(start, '…', cellOpts...)
+		}
+		prefixBudget := (budget + 1) / 2 // Bias the extra cell to the prefix.
+		suffixBudget := budget - prefixBudget
+
+		prefix, _ := splitAtWidth(content, prefixBudget)
+		suffix := lastFit(content, suffixBudget)
+
+		start := image.Point{X: alignStart(minX, maxX, cellWidth(prefix)+1+cellWidth(suffix), hAlign), Y: y}
+		if err := writeCells(cvs, prefix, start, cellOpts); err != nil {
+			return err
+		}
+		ellipsis := image.Point{X: start.X + cellWidth(prefix), Y: y}
+		if err := cvs.SetCell(ellipsis, '…', cellOpts...); err != nil {
+			return err
+		}
+		suffixStart := image.Point{X: ellipsis.X + 1, Y: y}
+		return writeCells(cvs, suffix, suffixStart, cellOpts)
+
+	default:
+		return fmt.Errorf("unsupported OverrunMode(%d)", om)
+	}
+}
+
+// writeCells draws content left to right starting at start, advancing by the
+// cell width of each rune.
+func writeCells(cvs *canvas.Canvas, content string, start image.Point, cellOpts []cell.Option) error {
+	x := start.X
+	for _, r := range content {
+		if err := cvs.SetCell(image.Point{X: x, Y: start.Y}, r, cellOpts...); err != nil {
+			return err
+		}
+		x += runeWidth(r)
+	}
+	return nil
+}
+
+// splitAtWidth returns the longest prefix of s whose cell width doesn't
+// exceed width, never splitting a full-width rune in half, along with the
+// unconsumed remainder of s.
+func splitAtWidth(s string, width int) (fit string, rest string) {
+	var used int
+	for i, r := range s {
+		w := runeWidth(r)
+		if used+w > width {
+			return s[:i], s[i:]
+		}
+		used += w
+	}
+	return s, ""
+}
+
+// lastFit returns the longest suffix of s whose cell width doesn't exceed
+// width, never splitting a full-width rune in half.
+func lastFit(s string, width int) string {
+	runes := []rune(s)
+	start := len(runes)
+	var used int
+	for i := len(runes) - 1; i >= 0; i-- {
+		w := runeWidth(runes[i])
+		if used+w > width {
+			break
+		}
+		used += w
+		start = i
+	}
+	return string(runes[start:])
+}
+
+// trimToWordBoundary backs s up to the last run of whitespace it contains,
+// dropping the partial word (if any) that follows. Returns ok == false if s
+// contains no whitespace, in which case s is returned unmodified.
+func trimToWordBoundary(s string) (trimmed string, ok bool) {
+	idx := strings.LastIndexFunc(s, unicode.IsSpace)
+	if idx < 0 {
+		return s, false
+	}
+	return strings.TrimRightFunc(s[:idx], unicode.IsSpace), true
+}
+
+// cellWidth returns the number of cells needed to display s.
+func cellWidth(s string) int {
+	var width int
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// nextCharBreakRow returns the longest prefix of s that fits within width
+// cells and the remainder of s that didn't fit on this row.
+func nextCharBreakRow(s string, width int) (row, rest string) {
+	return splitAtWidth(s, width)
+}
+
+// nextWordBreakRow greedily packs whitespace-separated words from s onto one
+// row of width cells, preferring to break between words. A word that alone
+// exceeds width and starts an otherwise empty row falls back to breaking at
+// cell boundaries.
+func nextWordBreakRow(s string, width int) (row, rest string) {
+	var b strings.Builder
+	var used, beforeSep int
+	i := 0
+	for i < len(s) {
+		r, _ := utf8.DecodeRuneInString(s[i:])
+
+		if unicode.IsSpace(r) {
+			// A run of whitespace separates words. Within a row it
+			// collapses to a single space; leading whitespace is dropped.
+			j := i
+			for j < len(s) {
+				rr, sz := utf8.DecodeRuneInString(s[j:])
+				if !unicode.IsSpace(rr) {
+					break
+				}
+				j += sz
+			}
+			if b.Len() == 0 {
+				i = j
+				continue
+			}
+			if used+1 > width {
+				return b.String(), s[j:]
+			}
+			beforeSep = b.Len()
+			b.WriteRune(' ')
+			used++
+			i = j
+			continue
+		}
+
+		j := i
+		for j < len(s) {
+			rr, sz := utf8.DecodeRuneInString(s[j:])
+			if unicode.IsSpace(rr) {
+				break
+			}
+			j += sz
+		}
+		word := s[i:j]
+		wWidth := cellWidth(word)
+
+		if used == 0 && wWidth > width {
+			fit, rest := splitAtWidth(word, width)
+			b.WriteString(fit)
+			return b.String(), rest + s[j:]
+		}
+		if used+wWidth > width {
+			// The separator speculatively written before this word doesn't
+			// belong on this row either, drop it so the row doesn't end
+			// with a trailing space.
+			return b.String()[:beforeSep], s[i:]
+		}
+		b.WriteString(word)
+		used += wWidth
+		i = j
+	}
+	return b.String(), ""
+}
+
+// runeWidth returns the number of cells the rune r occupies on the
+// terminal, either one or two for full-width (wide) runes such as CJK
+// ideographs.
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x1100:
+		return 1
+	case r <= 0x115f, // Hangul Jamo.
+		r == 0x2329, r == 0x232a,
+		(r >= 0x2e80 && r <= 0xa4cf && r != 0x303f), // CJK ... Yi.
+		(r >= 0xac00 && r <= 0xd7a3),                // Hangul Syllables.
+		(r >= 0xf900 && r <= 0xfaff),                // CJK Compatibility Ideographs.
+		(r >= 0xfe30 && r <= 0xfe6f),                // CJK Compatibility Forms.
+		(r >= 0xff00 && r <= 0xff60),                // Fullwidth Forms.
+		(r >= 0xffe0 && r <= 0xffe6),
+		(r >= 0x20000 && r <= 0x2fffd),
+		(r >= 0x30000 && r <= 0x3fffd):
+		return 2
+	default:
+		return 1
+	}
+}
@@ -387,6 +387,382 @@ func TestText(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "text falls outside of the canvas on OverrunModeMiddle",
+			canvas: image.Rect(0, 0, 1, 1),
+			text:   "ab",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextOverrunMode(OverrunModeMiddle),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '…')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "OverrunModeMiddle splits an even budget evenly between the prefix and the suffix",
+			canvas: image.Rect(0, 0, 5, 1),
+			text:   "abcdefg",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextOverrunMode(OverrunModeMiddle),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'b')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, '…')
+				testcanvas.MustSetCell(c, image.Point{3, 0}, 'f')
+				testcanvas.MustSetCell(c, image.Point{4, 0}, 'g')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "OverrunModeMiddle never splits a full-width rune",
+			canvas: image.Rect(0, 0, 4, 1),
+			text:   "a界cd",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextOverrunMode(OverrunModeMiddle),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, '…')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, 'd')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "OverrunModeWord backs up to the previous whitespace boundary",
+			canvas: image.Rect(0, 0, 8, 1),
+			text:   "hello world",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextOverrunMode(OverrunModeWord),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'h')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'e')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, 'l')
+				testcanvas.MustSetCell(c, image.Point{3, 0}, 'l')
+				testcanvas.MustSetCell(c, image.Point{4, 0}, 'o')
+				testcanvas.MustSetCell(c, image.Point{5, 0}, '…')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "OverrunModeWord falls back to a hard trim when there's no whitespace",
+			canvas: image.Rect(0, 0, 2, 1),
+			text:   "abcdef",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextOverrunMode(OverrunModeWord),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, '…')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "unsupported wrap mode specified",
+			canvas: image.Rect(0, 0, 1, 1),
+			text:   "a",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextWrapMode(WrapMode(-1)),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "requested MaxY is negative",
+			canvas: image.Rect(0, 0, 1, 1),
+			text:   "",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextMaxY(-1),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "requested MaxY is greater than canvas height",
+			canvas: image.Rect(0, 0, 1, 1),
+			text:   "",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextMaxY(2),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "WrapModeCharBreak wraps onto multiple rows",
+			canvas: image.Rect(0, 0, 2, 3),
+			text:   "abcdef",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextWrapMode(WrapModeCharBreak),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'b')
+				testcanvas.MustSetCell(c, image.Point{0, 1}, 'c')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, 'd')
+				testcanvas.MustSetCell(c, image.Point{0, 2}, 'e')
+				testcanvas.MustSetCell(c, image.Point{1, 2}, 'f')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "WrapModeCharBreak respects MaxY and trims the last row",
+			canvas: image.Rect(0, 0, 2, 3),
+			text:   "abcdef",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextWrapMode(WrapModeCharBreak),
+				TextMaxY(2),
+				TextOverrunMode(OverrunModeThreeDot),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'b')
+				testcanvas.MustSetCell(c, image.Point{0, 1}, 'c')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '…')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "WrapModeCharBreak never splits a full-width rune across rows",
+			canvas: image.Rect(0, 0, 3, 2),
+			text:   "a界b",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextWrapMode(WrapModeCharBreak),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, '界')
+				testcanvas.MustSetCell(c, image.Point{0, 1}, 'b')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "WrapModeWordBreak packs whole words onto rows",
+			canvas: image.Rect(0, 0, 5, 2),
+			text:   "ab cd",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextWrapMode(WrapModeWordBreak),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'b')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, ' ')
+				testcanvas.MustSetCell(c, image.Point{3, 0}, 'c')
+				testcanvas.MustSetCell(c, image.Point{4, 0}, 'd')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "WrapModeWordBreak moves an overflowing word onto the next row",
+			canvas: image.Rect(0, 0, 4, 2),
+			text:   "ab cd",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextWrapMode(WrapModeWordBreak),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'b')
+				testcanvas.MustSetCell(c, image.Point{0, 1}, 'c')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, 'd')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "WrapModeWordBreak breaks a word too long for an empty row",
+			canvas: image.Rect(0, 0, 2, 2),
+			text:   "abcd",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextWrapMode(WrapModeWordBreak),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'b')
+				testcanvas.MustSetCell(c, image.Point{0, 1}, 'c')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, 'd')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "unsupported horizontal alignment specified",
+			canvas: image.Rect(0, 0, 1, 1),
+			text:   "a",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextHAlign(HAlign(-1)),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "TextBounds with a negative Min.X is invalid",
+			canvas: image.Rect(0, 0, 3, 1),
+			text:   "a",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextBounds(image.Rect(-1, 0, 3, 1)),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "TextBounds with a Max.X beyond the canvas is invalid",
+			canvas: image.Rect(0, 0, 3, 1),
+			text:   "a",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextBounds(image.Rect(0, 0, 4, 1)),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "AlignRight hugs the text against the right edge",
+			canvas: image.Rect(0, 0, 5, 1),
+			text:   "ab",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextHAlign(AlignRight),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{3, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{4, 0}, 'b')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "AlignCenter centers the text, biasing it to the left when the gap is odd",
+			canvas: image.Rect(0, 0, 5, 1),
+			text:   "ab",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextHAlign(AlignCenter),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, 'b')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "AlignRight applies to the visible string, a trimmed ellipsis still hugs the right edge",
+			canvas: image.Rect(0, 0, 5, 1),
+			text:   "abcdef",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextOverrunMode(OverrunModeThreeDot),
+				TextHAlign(AlignRight),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'b')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, 'c')
+				testcanvas.MustSetCell(c, image.Point{3, 0}, 'd')
+				testcanvas.MustSetCell(c, image.Point{4, 0}, '…')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "TextBounds restricts alignment to the given cells of the row",
+			canvas: image.Rect(0, 0, 6, 1),
+			text:   "ab",
+			start:  image.Point{0, 0},
+			opts: []TextOption{
+				TextBounds(image.Rect(1, 0, 5, 1)),
+				TextHAlign(AlignRight),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{3, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{4, 0}, 'b')
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,51 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcellterm
+
+// color.go translates termdash's cell.Color and cell.Options into the style
+// type tcell expects.
+
+import (
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/mum4k/termdash/cell"
+)
+
+// toTcellColor converts a termdash cell.Color into the tcell color it maps
+// to. termdash colors are indices into the 256 color xterm palette, which
+// is exactly what tcell.PaletteColor expects, so the conversion is a direct
+// pass-through. A future truecolor cell.Color would be translated here too,
+// e.g. via tcell.NewRGBColor, without any change to the callers.
+func toTcellColor(c cell.Color) tcell.Color {
+	if c == cell.ColorDefault {
+		return tcell.ColorDefault
+	}
+	return tcell.PaletteColor(int(c))
+}
+
+// cellOptsToStyle converts the resolved cell options into the tcell.Style
+// used to paint a single cell.
+func cellOptsToStyle(opt *cell.Options) tcell.Style {
+	style := tcell.StyleDefault.
+		Foreground(toTcellColor(opt.FgColor)).
+		Background(toTcellColor(opt.BgColor)).
+		Bold(opt.Bold).
+		Italic(opt.Italic).
+		Underline(opt.Underline).
+		Blink(opt.Blink).
+		Reverse(opt.Inverse).
+		StrikeThrough(opt.Strikethrough)
+	return style
+}
@@ -0,0 +1,50 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcellterm
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/mum4k/termdash/cell"
+)
+
+func TestToTcellColor(t *testing.T) {
+	tests := []struct {
+		desc string
+		c    cell.Color
+		want tcell.Color
+	}{
+		{
+			desc: "default color maps to the terminal's default",
+			c:    cell.ColorDefault,
+			want: tcell.ColorDefault,
+		},
+		{
+			desc: "palette color maps to the same palette index",
+			c:    cell.ColorRed,
+			want: tcell.PaletteColor(int(cell.ColorRed)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := toTcellColor(tc.c); got != tc.want {
+				t.Errorf("toTcellColor(%v) => %v, want %v", tc.c, got, tc.want)
+			}
+		})
+	}
+}
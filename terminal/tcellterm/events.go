@@ -0,0 +1,114 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcellterm
+
+// events.go translates tcell's event model into termdash's terminalapi
+// events.
+
+import (
+	"image"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/mum4k/termdash/keyboard"
+	"github.com/mum4k/termdash/mouse"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+)
+
+// namedKeys maps the tcell key constants that have a direct termdash
+// keyboard.Key equivalent. Keys that produce a rune (e.g. plain letters)
+// are translated via their tcell.EventKey.Rune instead, see toKeyboardEvent.
+var namedKeys = map[tcell.Key]keyboard.Key{
+	tcell.KeyEnter:      keyboard.KeyEnter,
+	tcell.KeyEsc:        keyboard.KeyEsc,
+	tcell.KeyBackspace:  keyboard.KeyBackspace,
+	tcell.KeyBackspace2: keyboard.KeyBackspace,
+	tcell.KeyTab:        keyboard.KeyTab,
+	tcell.KeyDelete:     keyboard.KeyDelete,
+	tcell.KeyHome:       keyboard.KeyHome,
+	tcell.KeyEnd:        keyboard.KeyEnd,
+	tcell.KeyPgUp:       keyboard.KeyPgUp,
+	tcell.KeyPgDn:       keyboard.KeyPgDn,
+	tcell.KeyUp:         keyboard.KeyArrowUp,
+	tcell.KeyDown:       keyboard.KeyArrowDown,
+	tcell.KeyLeft:       keyboard.KeyArrowLeft,
+	tcell.KeyRight:      keyboard.KeyArrowRight,
+	tcell.KeyCtrlC:      keyboard.KeyCtrlC,
+}
+
+// mouseButtons maps the tcell mouse button mask to termdash's mouse.Button.
+// tcell reports the held buttons as a bit mask; termdash models a single
+// button per event, so the first button found below wins.
+var mouseButtons = []struct {
+	mask tcell.ButtonMask
+	btn  mouse.Button
+}{
+	{tcell.ButtonPrimary, mouse.ButtonLeft},
+	{tcell.ButtonSecondary, mouse.ButtonRight},
+	{tcell.ButtonMiddle, mouse.ButtonMiddle},
+	{tcell.WheelUp, mouse.ButtonWheelUp},
+	{tcell.WheelDown, mouse.ButtonWheelDown},
+}
+
+// toTermdashEvent converts a tcell event into its terminalapi equivalent.
+// Unrecognized events are converted into a terminalapi.Error so that callers
+// learn about them instead of silently dropping input.
+func toTermdashEvent(ev tcell.Event) terminalapi.Event {
+	switch ev := ev.(type) {
+	case *tcell.EventKey:
+		return toKeyboardEvent(ev)
+
+	case *tcell.EventMouse:
+		return toMouseEvent(ev)
+
+	case *tcell.EventResize:
+		w, h := ev.Size()
+		return &terminalapi.Resize{Size: image.Point{X: w, Y: h}}
+
+	case *tcell.EventError:
+		return terminalapi.NewErrorf("tcell event error: %v", ev.Error())
+
+	default:
+		return terminalapi.NewErrorf("unsupported tcell event %T", ev)
+	}
+}
+
+// toKeyboardEvent converts a tcell key event into terminalapi.Keyboard.
+func toKeyboardEvent(ev *tcell.EventKey) terminalapi.Event {
+	if b, ok := namedKeys[ev.Key()]; ok {
+		return &terminalapi.Keyboard{Key: b}
+	}
+	if ev.Key() == tcell.KeyRune {
+		return &terminalapi.Keyboard{Key: keyboard.Key(ev.Rune())}
+	}
+	return terminalapi.NewErrorf("unsupported tcell key %v", ev.Key())
+}
+
+// toMouseEvent converts a tcell mouse event into terminalapi.Mouse.
+func toMouseEvent(ev *tcell.EventMouse) terminalapi.Event {
+	x, y := ev.Position()
+	m := &terminalapi.Mouse{
+		Position: image.Point{X: x, Y: y},
+	}
+
+	buttons := ev.Buttons()
+	for _, b := range mouseButtons {
+		if buttons&b.mask != 0 {
+			m.Button = b.btn
+			break
+		}
+	}
+	return m
+}
@@ -0,0 +1,152 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcellterm
+
+import (
+	"errors"
+	"image"
+	"reflect"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/mum4k/termdash/keyboard"
+	"github.com/mum4k/termdash/mouse"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+)
+
+func TestToKeyboardEvent(t *testing.T) {
+	tests := []struct {
+		desc string
+		ev   *tcell.EventKey
+		want terminalapi.Event
+	}{
+		{
+			desc: "named key translates via namedKeys",
+			ev:   tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone),
+			want: &terminalapi.Keyboard{Key: keyboard.KeyEnter},
+		},
+		{
+			desc: "the second backspace key also maps to KeyBackspace",
+			ev:   tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModNone),
+			want: &terminalapi.Keyboard{Key: keyboard.KeyBackspace},
+		},
+		{
+			desc: "arrow key translates via namedKeys",
+			ev:   tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone),
+			want: &terminalapi.Keyboard{Key: keyboard.KeyArrowUp},
+		},
+		{
+			desc: "plain rune key translates via its rune",
+			ev:   tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone),
+			want: &terminalapi.Keyboard{Key: keyboard.Key('a')},
+		},
+		{
+			desc: "unsupported key becomes a terminalapi.Error",
+			ev:   tcell.NewEventKey(tcell.KeyF64, 0, tcell.ModNone),
+			want: terminalapi.NewErrorf("unsupported tcell key %v", tcell.KeyF64),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := toKeyboardEvent(tc.ev)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("toKeyboardEvent(%v) => %v, want %v", tc.ev, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestToMouseEvent(t *testing.T) {
+	tests := []struct {
+		desc string
+		ev   *tcell.EventMouse
+		want *terminalapi.Mouse
+	}{
+		{
+			desc: "left button click",
+			ev:   tcell.NewEventMouse(3, 4, tcell.ButtonPrimary, tcell.ModNone),
+			want: &terminalapi.Mouse{Position: image.Point{X: 3, Y: 4}, Button: mouse.ButtonLeft},
+		},
+		{
+			desc: "right button click",
+			ev:   tcell.NewEventMouse(1, 2, tcell.ButtonSecondary, tcell.ModNone),
+			want: &terminalapi.Mouse{Position: image.Point{X: 1, Y: 2}, Button: mouse.ButtonRight},
+		},
+		{
+			desc: "wheel up",
+			ev:   tcell.NewEventMouse(0, 0, tcell.WheelUp, tcell.ModNone),
+			want: &terminalapi.Mouse{Position: image.Point{X: 0, Y: 0}, Button: mouse.ButtonWheelUp},
+		},
+		{
+			desc: "no buttons held",
+			ev:   tcell.NewEventMouse(5, 5, tcell.ButtonNone, tcell.ModNone),
+			want: &terminalapi.Mouse{Position: image.Point{X: 5, Y: 5}},
+		},
+		{
+			desc: "first matching button wins when multiple are held",
+			ev:   tcell.NewEventMouse(0, 0, tcell.ButtonPrimary|tcell.ButtonSecondary, tcell.ModNone),
+			want: &terminalapi.Mouse{Position: image.Point{X: 0, Y: 0}, Button: mouse.ButtonLeft},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := toMouseEvent(tc.ev)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("toMouseEvent(%v) => %v, want %v", tc.ev, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestToTermdashEvent(t *testing.T) {
+	tests := []struct {
+		desc string
+		ev   tcell.Event
+		want terminalapi.Event
+	}{
+		{
+			desc: "key event delegates to toKeyboardEvent",
+			ev:   tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone),
+			want: &terminalapi.Keyboard{Key: keyboard.KeyEsc},
+		},
+		{
+			desc: "mouse event delegates to toMouseEvent",
+			ev:   tcell.NewEventMouse(7, 8, tcell.ButtonPrimary, tcell.ModNone),
+			want: &terminalapi.Mouse{Position: image.Point{X: 7, Y: 8}, Button: mouse.ButtonLeft},
+		},
+		{
+			desc: "resize event carries the new size",
+			ev:   tcell.NewEventResize(80, 24),
+			want: &terminalapi.Resize{Size: image.Point{X: 80, Y: 24}},
+		},
+		{
+			desc: "error event becomes a terminalapi.Error",
+			ev:   tcell.NewEventError(errors.New("broken pipe")),
+			want: terminalapi.NewErrorf("tcell event error: %v", errors.New("broken pipe")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := toTermdashEvent(tc.ev)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("toTermdashEvent(%v) => %v, want %v", tc.ev, got, tc.want)
+			}
+		})
+	}
+}
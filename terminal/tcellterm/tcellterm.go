@@ -0,0 +1,124 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tcellterm implements terminalapi.Terminal backed by
+// github.com/gdamore/tcell/v2.
+//
+// Compared to the termbox backend, tcell is actively maintained, has better
+// Windows support, supports truecolor and a richer event and mouse model,
+// and measures wide characters itself instead of relying on a separate
+// rune-width table. Use this backend when those properties matter more than
+// the smaller dependency footprint of termbox.
+package tcellterm
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+)
+
+// Terminal provides input and output to a real terminal via tcell. Implements
+// terminalapi.Terminal.
+//
+// This object is not thread-safe, the owner must ensure mutual exclusion
+// across all the public methods.
+type Terminal struct {
+	// screen is the tcell screen this terminal draws to and reads events
+	// from.
+	screen tcell.Screen
+}
+
+// Option is used to provide options to New.
+type Option interface {
+	set(*Terminal)
+}
+
+// New returns a new tcell based Terminal, ready to be drawn to. Call Close
+// when the terminal is no longer needed in order to restore the state of
+// the real terminal.
+func New(opts ...Option) (*Terminal, error) {
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return nil, fmt.Errorf("tcell.NewScreen => %v", err)
+	}
+	if err := screen.Init(); err != nil {
+		return nil, fmt.Errorf("screen.Init => %v", err)
+	}
+	screen.EnableMouse()
+
+	t := &Terminal{
+		screen: screen,
+	}
+	for _, opt := range opts {
+		opt.set(t)
+	}
+	return t, nil
+}
+
+// Size implements terminalapi.Terminal.Size.
+func (t *Terminal) Size() image.Point {
+	w, h := t.screen.Size()
+	return image.Point{X: w, Y: h}
+}
+
+// Clear implements terminalapi.Terminal.Clear.
+func (t *Terminal) Clear(opts ...cell.Option) error {
+	opt := cell.NewOptions(opts...)
+	t.screen.SetStyle(cellOptsToStyle(opt))
+	t.screen.Clear()
+	return nil
+}
+
+// Flush implements terminalapi.Terminal.Flush.
+func (t *Terminal) Flush() error {
+	t.screen.Show()
+	return nil
+}
+
+// SetCursor implements terminalapi.Terminal.SetCursor.
+func (t *Terminal) SetCursor(p image.Point) {
+	t.screen.ShowCursor(p.X, p.Y)
+}
+
+// HideCursor implements terminalapi.Terminal.HideCursor.
+func (t *Terminal) HideCursor() {
+	t.screen.HideCursor()
+}
+
+// SetCell implements terminalapi.Terminal.SetCell.
+func (t *Terminal) SetCell(p image.Point, r rune, opts ...cell.Option) error {
+	opt := cell.NewOptions(opts...)
+	t.screen.SetContent(p.X, p.Y, r, nil, cellOptsToStyle(opt))
+	return nil
+}
+
+// Close closes the terminal, should be called when the terminal isn't
+// required anymore to return the screen to its original state.
+func (t *Terminal) Close() error {
+	t.screen.Fini()
+	return nil
+}
+
+// Event implements terminalapi.Terminal.Event.
+//
+// This is a blocking call, it returns the next event coming from the
+// terminal, translated from tcell's own event model into termdash's
+// terminalapi.Event.
+func (t *Terminal) Event() terminalapi.Event {
+	return toTermdashEvent(t.screen.PollEvent())
+}
@@ -0,0 +1,80 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcellterm
+
+import (
+	"image"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/mum4k/termdash/cell"
+)
+
+// newTestTerminal returns a Terminal backed by a tcell simulation screen of
+// the given size, bypassing New (which requires a real terminal).
+func newTestTerminal(t *testing.T, size image.Point) *Terminal {
+	t.Helper()
+
+	sim := tcell.NewSimulationScreen("")
+	if err := sim.Init(); err != nil {
+		t.Fatalf("sim.Init => unexpected error: %v", err)
+	}
+	sim.SetSize(size.X, size.Y)
+	return &Terminal{screen: sim}
+}
+
+func TestSize(t *testing.T) {
+	term := newTestTerminal(t, image.Point{5, 3})
+	if got, want := term.Size(), (image.Point{5, 3}); got != want {
+		t.Errorf("Size => %v, want %v", got, want)
+	}
+}
+
+func TestSetCell(t *testing.T) {
+	term := newTestTerminal(t, image.Point{3, 1})
+	if err := term.SetCell(image.Point{1, 0}, 'x', cell.FgColor(cell.ColorRed)); err != nil {
+		t.Fatalf("SetCell => unexpected error: %v", err)
+	}
+	if err := term.Flush(); err != nil {
+		t.Fatalf("Flush => unexpected error: %v", err)
+	}
+
+	sim := term.screen.(tcell.SimulationScreen)
+	cells, _, _ := sim.GetContents()
+	got := cells[1].Runes[0]
+	if want := 'x'; got != want {
+		t.Errorf("GetContents => rune %c at (1,0), want %c", got, want)
+	}
+}
+
+func TestClear(t *testing.T) {
+	term := newTestTerminal(t, image.Point{2, 1})
+	if err := term.SetCell(image.Point{0, 0}, 'x'); err != nil {
+		t.Fatalf("SetCell => unexpected error: %v", err)
+	}
+	if err := term.Clear(); err != nil {
+		t.Fatalf("Clear => unexpected error: %v", err)
+	}
+	if err := term.Flush(); err != nil {
+		t.Fatalf("Flush => unexpected error: %v", err)
+	}
+
+	sim := term.screen.(tcell.SimulationScreen)
+	cells, _, _ := sim.GetContents()
+	if got, want := cells[0].Runes[0], ' '; got != want {
+		t.Errorf("GetContents => rune %c at (0,0) after Clear, want %c", got, want)
+	}
+}